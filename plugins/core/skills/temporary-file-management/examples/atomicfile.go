@@ -0,0 +1,42 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// AtomicWriteFile writes data to path atomically: it creates a temp file in
+// the same directory as path, writes and fsyncs it, then renames it into
+// place. Writing to a sibling temp file and renaming guarantees readers
+// never observe a partially written file, since rename is atomic within a
+// filesystem. On any error, the temp file is removed.
+func AtomicWriteFile(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath)
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+
+	return syncDir(dir)
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jalvarezsamayoa/vscode-copilot-plugins/plugins/core/skills/temporary-file-management/examples/tempfileutil"
+)
+
+func TestAtomicWriteFileCreatesFile(t *testing.T) {
+	dir := tempfileutil.TempDir(t, "atomicfile")
+	path := filepath.Join(dir, "out.txt")
+
+	if err := AtomicWriteFile(path, []byte("hello"), 0644); err != nil {
+		t.Fatalf("AtomicWriteFile failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected file to exist: %v", err)
+	}
+	if string(data) != "hello" {
+		t.Fatalf("expected content %q, got %q", "hello", string(data))
+	}
+}
+
+func TestAtomicWriteFileOverwritesExisting(t *testing.T) {
+	dir := tempfileutil.TempDir(t, "atomicfile")
+	path := filepath.Join(dir, "out.txt")
+
+	if err := os.WriteFile(path, []byte("old"), 0644); err != nil {
+		t.Fatalf("failed to seed existing file: %v", err)
+	}
+	if err := AtomicWriteFile(path, []byte("new"), 0644); err != nil {
+		t.Fatalf("AtomicWriteFile failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected file to exist: %v", err)
+	}
+	if string(data) != "new" {
+		t.Fatalf("expected content %q, got %q", "new", string(data))
+	}
+}
+
+func TestAtomicWriteFileLeavesNoTempFileBehind(t *testing.T) {
+	dir := tempfileutil.TempDir(t, "atomicfile")
+	path := filepath.Join(dir, "out.txt")
+
+	if err := AtomicWriteFile(path, []byte("hello"), 0644); err != nil {
+		t.Fatalf("AtomicWriteFile failed: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to read dir: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "out.txt" {
+		t.Fatalf("expected only out.txt in %s, got %v", dir, entries)
+	}
+}
@@ -0,0 +1,17 @@
+//go:build unix
+
+package main
+
+import "os"
+
+// syncDir fsyncs dir so that the preceding rename is durable across a crash,
+// not just visible to other processes.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
@@ -0,0 +1,10 @@
+//go:build windows
+
+package main
+
+// syncDir is a no-op on Windows: directories cannot be opened with Sync
+// semantics, and NTFS's rename implementation does not expose the same
+// durability gap that fsync-ing a Unix directory entry closes.
+func syncDir(dir string) error {
+	return nil
+}
@@ -0,0 +1,34 @@
+// Package diskspace reports available disk space for a directory so
+// callers can preflight-check before writing large temporary files.
+package diskspace
+
+import "fmt"
+
+// ErrInsufficientSpace is returned by CheckTempSpace when the filesystem
+// containing dir has fewer than required bytes free.
+type ErrInsufficientSpace struct {
+	Dir       string
+	Available int64
+	Required  int64
+}
+
+func (e *ErrInsufficientSpace) Error() string {
+	return fmt.Sprintf("diskspace: insufficient space in %s: available %d bytes, required %d bytes", e.Dir, e.Available, e.Required)
+}
+
+// CheckTempSpace returns an error if the filesystem containing dir has
+// fewer than required bytes of free space available. It returns
+// *ErrInsufficientSpace when the check succeeds but space is insufficient,
+// and a plain error if the available space itself could not be determined.
+func CheckTempSpace(dir string, required int64) error {
+	available, err := availableBytes(dir)
+	if err != nil {
+		return fmt.Errorf("diskspace: failed to determine available space for %s: %w", dir, err)
+	}
+
+	if available < required {
+		return &ErrInsufficientSpace{Dir: dir, Available: available, Required: required}
+	}
+
+	return nil
+}
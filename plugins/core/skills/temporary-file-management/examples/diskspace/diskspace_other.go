@@ -0,0 +1,14 @@
+//go:build !linux && !darwin && !freebsd && !dragonfly && !windows
+
+package diskspace
+
+import "fmt"
+
+// availableBytes is unimplemented on this platform. syscall.Statfs_t's
+// Bavail/Bsize fields (used on linux/darwin/freebsd/dragonfly) and
+// GetDiskFreeSpaceExW (used on windows) don't have verified equivalents
+// here, so this keeps the package building everywhere rather than
+// silently reporting a wrong number.
+func availableBytes(dir string) (int64, error) {
+	return 0, fmt.Errorf("diskspace: CheckTempSpace is not implemented on this platform")
+}
@@ -0,0 +1,19 @@
+//go:build linux || darwin || freebsd || dragonfly
+
+package diskspace
+
+import "syscall"
+
+// availableBytes returns the number of bytes available to an unprivileged
+// user on the filesystem containing dir, using statfs(2). syscall.Statfs_t's
+// Bavail/Bsize fields and syscall.Statfs itself aren't available on every
+// Unix the "unix" build tag covers (e.g. netbsd, openbsd, solaris, illumos),
+// so this is scoped to the OSes actually verified to build.
+func availableBytes(dir string) (int64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return 0, err
+	}
+
+	return int64(stat.Bavail) * int64(stat.Bsize), nil
+}
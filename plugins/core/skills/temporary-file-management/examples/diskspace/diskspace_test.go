@@ -0,0 +1,31 @@
+package diskspace
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jalvarezsamayoa/vscode-copilot-plugins/plugins/core/skills/temporary-file-management/examples/tempfileutil"
+)
+
+func TestCheckTempSpaceSucceedsForSmallRequirement(t *testing.T) {
+	dir := tempfileutil.TempDir(t, "diskspace")
+
+	if err := CheckTempSpace(dir, 1); err != nil {
+		t.Fatalf("expected 1 byte requirement to be satisfiable, got: %v", err)
+	}
+}
+
+func TestCheckTempSpaceFailsForHugeRequirement(t *testing.T) {
+	dir := tempfileutil.TempDir(t, "diskspace")
+
+	const absurdlyLarge = 1 << 62
+	err := CheckTempSpace(dir, absurdlyLarge)
+	if err == nil {
+		t.Fatal("expected an error for an unsatisfiable space requirement")
+	}
+
+	var insufficient *ErrInsufficientSpace
+	if !errors.As(err, &insufficient) {
+		t.Fatalf("expected *ErrInsufficientSpace, got %T: %v", err, err)
+	}
+}
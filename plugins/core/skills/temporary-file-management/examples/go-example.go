@@ -2,17 +2,20 @@ package main
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
+	"testing"
+
+	"github.com/jalvarezsamayoa/vscode-copilot-plugins/plugins/core/skills/temporary-file-management/examples/diskspace"
+	"github.com/jalvarezsamayoa/vscode-copilot-plugins/plugins/core/skills/temporary-file-management/examples/tempfileutil"
 )
 
-// Example 1: Using ioutil.TempFile
+// Example 1: Using os.CreateTemp
 func example1() {
 	fmt.Println("✓ Example 1: Basic temporary file")
 
 	// Create temporary file
-	tempFile, err := ioutil.TempFile("", "example-*.txt")
+	tempFile, err := os.CreateTemp("", "example-*.txt")
 	if err != nil {
 		panic(err)
 	}
@@ -27,20 +30,20 @@ func example1() {
 	tempFile.Close()
 
 	// Read data back
-	data, err := ioutil.ReadFile(tempFile.Name())
+	data, err := os.ReadFile(tempFile.Name())
 	if err != nil {
 		panic(err)
 	}
 	fmt.Printf("  Content: %s\n", string(data[:30]))
-	fmt.Println("  Cleaned up\n")
+	fmt.Println("  Cleaned up")
 }
 
-// Example 2: Using ioutil.TempDir
+// Example 2: Using os.MkdirTemp
 func example2() {
 	fmt.Println("✓ Example 2: Temporary directory")
 
 	// Create temporary directory
-	tempDir, err := ioutil.TempDir("", "example-dir-*")
+	tempDir, err := os.MkdirTemp("", "example-dir-*")
 	if err != nil {
 		panic(err)
 	}
@@ -52,30 +55,30 @@ func example2() {
 	for i := 0; i < 3; i++ {
 		filePath := filepath.Join(tempDir, fmt.Sprintf("file%d.txt", i))
 		content := fmt.Sprintf("File %d content\n", i)
-		if err := ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
 			panic(err)
 		}
 	}
 
 	// List files
-	files, err := ioutil.ReadDir(tempDir)
+	entries, err := os.ReadDir(tempDir)
 	if err != nil {
 		panic(err)
 	}
 
 	fmt.Printf("  Files created: ")
-	for _, f := range files {
-		fmt.Printf("%s ", f.Name())
+	for _, e := range entries {
+		fmt.Printf("%s ", e.Name())
 	}
 	fmt.Println()
-	fmt.Println("  Cleaned up\n")
+	fmt.Println("  Cleaned up")
 }
 
 // Example 3: With explicit cleanup control
 func example3() {
 	fmt.Println("✓ Example 3: Manual cleanup control")
 
-	tempFile, err := ioutil.TempFile("", "manual-*.txt")
+	tempFile, err := os.CreateTemp("", "manual-*.txt")
 	if err != nil {
 		panic(err)
 	}
@@ -88,19 +91,19 @@ func example3() {
 	tempFile.Close()
 
 	// Use the file
-	data, _ := ioutil.ReadFile(tempPath)
+	data, _ := os.ReadFile(tempPath)
 	fmt.Printf("  Content: %s", string(data))
 
 	// Manual cleanup
 	os.Remove(tempPath)
-	fmt.Println("  Manually cleaned up\n")
+	fmt.Println("  Manually cleaned up")
 }
 
 // Example 4: Safer cleanup with error handling
 func example4() {
 	fmt.Println("✓ Example 4: Error-safe cleanup")
 
-	tempFile, err := ioutil.TempFile("", "safe-*.txt")
+	tempFile, err := os.CreateTemp("", "safe-*.txt")
 	if err != nil {
 		panic(err)
 	}
@@ -119,16 +122,16 @@ func example4() {
 	tempFile.Close()
 
 	// Read and process
-	data, _ := ioutil.ReadFile(tempFile.Name())
+	data, _ := os.ReadFile(tempFile.Name())
 	fmt.Printf("  Content: %s", string(data))
-	fmt.Println("  Cleaned up with error handling\n")
+	fmt.Println("  Cleaned up with error handling")
 }
 
 // Example 5: Checking available space
 func example5() {
 	fmt.Println("✓ Example 5: Check temp space before use")
 
-	tempDir, err := ioutil.TempDir("", "space-check-*")
+	tempDir, err := os.MkdirTemp("", "space-check-*")
 	if err != nil {
 		panic(err)
 	}
@@ -136,27 +139,136 @@ func example5() {
 
 	fmt.Printf("  Temp directory: %s\n", tempDir)
 
-	// In production, you might check disk space here
-	// For this example, we just create and use it
 	testFile := filepath.Join(tempDir, "test.txt")
 	largeContent := make([]byte, 1024*1024) // 1MB
 
-	if err := ioutil.WriteFile(testFile, largeContent, 0644); err != nil {
+	if err := diskspace.CheckTempSpace(tempDir, int64(len(largeContent))); err != nil {
+		fmt.Printf("  Error: %v\n", err)
+		return
+	}
+	fmt.Println("  Sufficient space available")
+
+	if err := os.WriteFile(testFile, largeContent, 0644); err != nil {
 		fmt.Printf("  Error creating file: %v\n", err)
 	} else {
 		fmt.Println("  Successfully created 1MB test file")
 	}
-	fmt.Println("  Cleaned up\n")
+	fmt.Println("  Cleaned up")
+}
+
+// Example 6: os package equivalents side by side
+//
+// Go 1.16 deprecated the io/ioutil temp-file helpers in favor of
+// equivalents on os (and fs.DirEntry instead of os.FileInfo for
+// directory listings). This example is a quick reference for the
+// mapping:
+//
+//	ioutil.TempFile  -> os.CreateTemp
+//	ioutil.TempDir   -> os.MkdirTemp
+//	ioutil.ReadFile  -> os.ReadFile
+//	ioutil.WriteFile -> os.WriteFile
+//	ioutil.ReadDir   -> os.ReadDir (returns []fs.DirEntry, not []os.FileInfo)
+func example6() {
+	fmt.Println("✓ Example 6: io/ioutil -> os equivalents")
+
+	tempDir, err := os.MkdirTemp("", "modern-dir-*")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	tempFile, err := os.CreateTemp(tempDir, "modern-*.txt")
+	if err != nil {
+		panic(err)
+	}
+	defer tempFile.Close()
+
+	fmt.Printf("  Created: %s\n", tempFile.Name())
+
+	if err := os.WriteFile(tempFile.Name(), []byte("Modern os equivalents\n"), 0644); err != nil {
+		panic(err)
+	}
+
+	data, err := os.ReadFile(tempFile.Name())
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("  Content: %s", string(data))
+
+	// os.ReadDir returns []fs.DirEntry, so entries are stat'd lazily
+	// via Info() rather than eagerly like ioutil.ReadDir's []os.FileInfo.
+	entries, err := os.ReadDir(tempDir)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("  Entries: ")
+	for _, e := range entries {
+		fmt.Printf("%s ", e.Name())
+	}
+	fmt.Println()
+	fmt.Println("  Cleaned up")
+}
+
+// Example 7: testing.TB-scoped temp file/dir helpers
+//
+// tempfileutil.TempDir and tempfileutil.TempFile derive their prefix from
+// t.Name(), register cleanup via t.Cleanup, and honor TEST_NOCLEANUP=true
+// to retain artifacts for post-mortem debugging. They need a real
+// testing.TB, so this example is exercised from go-example_test.go via
+// `go test` rather than from main().
+func example7(t testing.TB) {
+	dir := tempfileutil.TempDir(t, "example7-dir")
+	f := tempfileutil.TempFile(t, "example7-file")
+
+	fmt.Printf("  Created dir: %s\n", dir)
+	fmt.Printf("  Created file: %s\n", f.Name())
+
+	if _, err := f.WriteString("Managed by tempfileutil\n"); err != nil {
+		panic(err)
+	}
+	fmt.Println("  Cleanup is handled automatically by t.Cleanup")
+}
+
+// Example 8: Atomic write via temp file + fsync + rename
+//
+// AtomicWriteFile writes to a sibling temp file and renames it into place,
+// so a reader never observes a partially written file and a crash never
+// leaves path in a half-written state.
+func example8() {
+	fmt.Println("✓ Example 8: Atomic write (write, fsync, rename)")
+
+	tempDir, err := os.MkdirTemp("", "atomic-*")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	target := filepath.Join(tempDir, "config.json")
+	if err := AtomicWriteFile(target, []byte(`{"version":1}`), 0644); err != nil {
+		panic(err)
+	}
+
+	data, err := os.ReadFile(target)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("  Wrote atomically: %s\n", target)
+	fmt.Printf("  Content: %s\n", string(data))
+	fmt.Println("  Cleaned up")
 }
 
 func main() {
-	fmt.Println("Temporary File Management Examples (Go)\n")
+	fmt.Println("Temporary File Management Examples (Go)")
+	fmt.Println()
 
 	example1()
 	example2()
 	example3()
 	example4()
 	example5()
+	example6()
+	// example7 requires a testing.TB; run `go test` to exercise it.
+	example8()
 
 	fmt.Println("✓ All examples completed")
 }
@@ -0,0 +1,7 @@
+package main
+
+import "testing"
+
+func TestExample7(t *testing.T) {
+	example7(t)
+}
@@ -0,0 +1,78 @@
+// Package tempfileutil provides testing.TB-scoped helpers for creating
+// temporary files and directories that clean themselves up when the test
+// finishes, with an escape hatch for keeping artifacts around for
+// post-mortem debugging.
+package tempfileutil
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// noCleanupEnv, when set to "true", preserves temp files and directories
+// created by this package instead of removing them during cleanup.
+const noCleanupEnv = "TEST_NOCLEANUP"
+
+// prefix derives a filesystem-safe prefix from the test name, replacing
+// "/" (used by subtests) with "_".
+func prefix(t testing.TB, name string) string {
+	return strings.ReplaceAll(t.Name(), "/", "_") + "-" + name
+}
+
+// noCleanup reports whether TEST_NOCLEANUP=true is set in the environment.
+func noCleanup() bool {
+	return os.Getenv(noCleanupEnv) == "true"
+}
+
+// TempDir creates a new temporary directory derived from t.Name() and name,
+// and registers a cleanup that removes it once the test completes. If
+// TEST_NOCLEANUP=true is set, the directory is retained and its path is
+// logged instead.
+func TempDir(t testing.TB, name string) string {
+	t.Helper()
+
+	dir, err := os.MkdirTemp("", prefix(t, name)+"-*")
+	if err != nil {
+		t.Fatalf("tempfileutil: failed to create temp dir: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if noCleanup() {
+			t.Logf("tempfileutil: TEST_NOCLEANUP set, retaining %s", dir)
+			return
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Errorf("tempfileutil: failed to remove temp dir %s: %v", dir, err)
+		}
+	})
+
+	return dir
+}
+
+// TempFile creates a new temporary file derived from t.Name() and name, and
+// registers a cleanup that closes and removes it once the test completes.
+// If TEST_NOCLEANUP=true is set, the file is retained and its path is
+// logged instead.
+func TempFile(t testing.TB, name string) *os.File {
+	t.Helper()
+
+	f, err := os.CreateTemp("", prefix(t, name)+"-*")
+	if err != nil {
+		t.Fatalf("tempfileutil: failed to create temp file: %v", err)
+	}
+
+	t.Cleanup(func() {
+		path := f.Name()
+		f.Close()
+		if noCleanup() {
+			t.Logf("tempfileutil: TEST_NOCLEANUP set, retaining %s", path)
+			return
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			t.Errorf("tempfileutil: failed to remove temp file %s: %v", path, err)
+		}
+	})
+
+	return f
+}
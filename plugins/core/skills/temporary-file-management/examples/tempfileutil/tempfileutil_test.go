@@ -0,0 +1,42 @@
+package tempfileutil
+
+import (
+	"os"
+	"testing"
+)
+
+func TestTempDir(t *testing.T) {
+	dir := TempDir(t, "mydir")
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		t.Fatalf("expected temp dir to exist: %v", err)
+	}
+	if !info.IsDir() {
+		t.Fatalf("expected %s to be a directory", dir)
+	}
+}
+
+func TestTempFile(t *testing.T) {
+	f := TempFile(t, "myfile")
+
+	if _, err := f.WriteString("hello"); err != nil {
+		t.Fatalf("failed to write to temp file: %v", err)
+	}
+
+	if _, err := os.Stat(f.Name()); err != nil {
+		t.Fatalf("expected temp file to exist: %v", err)
+	}
+}
+
+func TestNoCleanupEnv(t *testing.T) {
+	t.Setenv(noCleanupEnv, "true")
+	if !noCleanup() {
+		t.Fatal("expected noCleanup() to report true when TEST_NOCLEANUP=true")
+	}
+
+	t.Setenv(noCleanupEnv, "false")
+	if noCleanup() {
+		t.Fatal("expected noCleanup() to report false when TEST_NOCLEANUP=false")
+	}
+}